@@ -152,6 +152,14 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 				Name:    q.InsertIntoTable.Name,
 			}
 		}
+		var tables []*plugin.Identifier
+		for _, t := range q.Tables {
+			tables = append(tables, &plugin.Identifier{
+				Catalog: t.Catalog,
+				Schema:  t.Schema,
+				Name:    t.Name,
+			})
+		}
 		out = append(out, &plugin.Query{
 			Name:            q.Metadata.Name,
 			Cmd:             q.Metadata.Cmd,
@@ -161,6 +169,10 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 			Params:          params,
 			Filename:        q.Metadata.Filename,
 			InsertIntoTable: iit,
+			Tables:          tables,
+			JoinCount:       int32(q.JoinCount),
+			HasLimit:        q.HasLimit,
+			EstimatedRows:   q.EstimatedRows,
 		})
 	}
 	return out
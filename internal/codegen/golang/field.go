@@ -19,6 +19,9 @@ type Field struct {
 	Column  *plugin.Column
 	// EmbedFields contains the embedded fields that require scanning.
 	EmbedFields []Field
+	// Sensitive is true if the field is masked by the generated String and
+	// LogValue methods.
+	Sensitive bool
 }
 
 func (gf Field) Tag() string {
@@ -39,6 +39,7 @@ type tmplCtx struct {
 	EmitAllEnumValues         bool
 	UsesCopyFrom              bool
 	UsesBatch                 bool
+	UsesMaxRows               bool
 	OmitSqlcVersion           bool
 	BuildTags                 string
 	WrapErrors                bool
@@ -149,10 +150,63 @@ func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Q
 		}
 		structNames[struckt.Name] = struct{}{}
 	}
+	for _, struckt := range structs {
+		if err := validateRedaction(struckt); err != nil {
+			return err
+		}
+	}
+	for _, query := range queries {
+		for _, v := range []QueryValue{query.Arg, query.Ret} {
+			if v.IsStruct() {
+				if err := validateRedaction(*v.Struct); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if options.EmitQueryRegistry {
+		for _, name := range []string{"QueryName", "QueryParam", "QueryInfo"} {
+			if _, ok := enumNames[name]; ok {
+				return fmt.Errorf("enum name conflicts with query registry type: %s", name)
+			}
+			if _, ok := structNames[name]; ok {
+				return fmt.Errorf("struct name conflicts with query registry type: %s", name)
+			}
+		}
+		for _, query := range queries {
+			switch query.ConstantName {
+			case "queryRegistry", "AllQueries", "LookupQuery":
+				return fmt.Errorf("query constant name conflicts with query registry: %s", query.ConstantName)
+			}
+		}
+	}
+	if usesMaxRows(queries) {
+		if _, ok := enumNames["MaxRowsError"]; ok {
+			return fmt.Errorf("enum name conflicts with max rows error type: MaxRowsError")
+		}
+		if _, ok := structNames["MaxRowsError"]; ok {
+			return fmt.Errorf("struct name conflicts with max rows error type: MaxRowsError")
+		}
+	}
 	if !options.EmitExportedQueries {
 		return nil
 	}
+	registryNames := make(map[string]struct{})
+	if options.EmitQueryRegistry {
+		for _, name := range []string{"QueryName", "QueryParam", "QueryInfo"} {
+			registryNames[name] = struct{}{}
+		}
+		for _, query := range queries {
+			registryNames["QueryName"+query.MethodName] = struct{}{}
+		}
+	}
 	for _, query := range queries {
+		if _, ok := registryNames[query.ConstantName]; ok {
+			return fmt.Errorf("query constant name conflicts with query registry: %s", query.ConstantName)
+		}
+		if query.ConstantName == "MaxRowsError" && usesMaxRows(queries) {
+			return fmt.Errorf("query constant name conflicts with max rows error type: %s", query.ConstantName)
+		}
 		if _, ok := enumNames[query.ConstantName]; ok {
 			return fmt.Errorf("query constant name conflicts with enum name: %s", query.ConstantName)
 		}
@@ -163,6 +217,20 @@ func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Q
 	return nil
 }
 
+// validateRedaction checks that the String and LogValue methods generated for
+// structs with sensitive fields do not collide with a field name.
+func validateRedaction(s Struct) error {
+	if !s.HasSensitiveFields() {
+		return nil
+	}
+	for _, f := range s.Fields {
+		if f.Name == "String" || f.Name == "LogValue" {
+			return fmt.Errorf("struct %s has sensitive fields and a field named %s", s.Name, f.Name)
+		}
+	}
+	return nil
+}
+
 func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum, structs []Struct, queries []Query) (*plugin.GenerateResponse, error) {
 	i := &importer{
 		Options: options,
@@ -183,6 +251,7 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		EmitAllEnumValues:         options.EmitAllEnumValues,
 		UsesCopyFrom:              usesCopyFrom(queries),
 		UsesBatch:                 usesBatch(queries),
+		UsesMaxRows:               usesMaxRows(queries),
 		SQLDriver:                 parseDriver(options.SqlPackage),
 		Q:                         "`",
 		Package:                   options.Package,
@@ -283,6 +352,10 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 	if options.OutputCopyfromFileName != "" {
 		copyfromFileName = options.OutputCopyfromFileName
 	}
+	registryFileName := "registry.go"
+	if options.OutputRegistryFileName != "" {
+		registryFileName = options.OutputRegistryFileName
+	}
 
 	batchFileName := "batch.go"
 	if options.OutputBatchFileName != "" {
@@ -300,6 +373,11 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 			return nil, err
 		}
 	}
+	if options.EmitQueryRegistry {
+		if err := execute(registryFileName, "registryFile"); err != nil {
+			return nil, err
+		}
+	}
 	if tctx.UsesCopyFrom {
 		if err := execute(copyfromFileName, "copyfromFile"); err != nil {
 			return nil, err
@@ -353,6 +431,15 @@ func usesBatch(queries []Query) bool {
 	return false
 }
 
+func usesMaxRows(queries []Query) bool {
+	for _, q := range queries {
+		if q.MaxRows > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func checkNoTimesForMySQLCopyFrom(queries []Query) error {
 	for _, q := range queries {
 		if q.Cmd != metadata.CmdCopyFrom {
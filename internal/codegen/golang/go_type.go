@@ -1,6 +1,7 @@
 package golang
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
@@ -39,6 +40,49 @@ func addExtraGoStructTags(tags map[string]string, req *plugin.GenerateRequest, o
 	}
 }
 
+// isSensitive reports whether a column is listed in the sensitive_columns
+// option or carries the @sensitive marker in its comment.
+func isSensitive(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) bool {
+	cname := col.Name
+	if col.OriginalName != "" {
+		cname = col.OriginalName
+	}
+	comment := col.Comment
+	if comment == "" {
+		// Parameters don't carry the comment of the column they bind to
+		comment = catalogColumnComment(req, col.Table, cname)
+	}
+	if slices.Contains(strings.Fields(comment), "@sensitive") {
+		return true
+	}
+	for _, o := range options.SensitiveMatches {
+		if o.Matches(col.Table, req.Catalog.DefaultSchema) && o.ColumnName.MatchString(cname) {
+			return true
+		}
+	}
+	return false
+}
+
+func catalogColumnComment(req *plugin.GenerateRequest, table *plugin.Identifier, name string) string {
+	if table == nil {
+		return ""
+	}
+	for _, schema := range req.Catalog.Schemas {
+		for _, t := range schema.Tables {
+			rel := &plugin.Identifier{Catalog: t.Rel.Catalog, Schema: schema.Name, Name: t.Rel.Name}
+			if !sdk.SameTableName(table, rel, req.Catalog.DefaultSchema) {
+				continue
+			}
+			for _, c := range t.Columns {
+				if c.Name == name {
+					return c.Comment
+				}
+			}
+		}
+	}
+	return ""
+}
+
 func goType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
 	// Check if the column's type has been overridden
 	for _, override := range options.Overrides {
@@ -101,6 +101,10 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 	if i.Options.OutputBatchFileName != "" {
 		batchFileName = i.Options.OutputBatchFileName
 	}
+	registryFileName := "registry.go"
+	if i.Options.OutputRegistryFileName != "" {
+		registryFileName = i.Options.OutputRegistryFileName
+	}
 
 	switch filename {
 	case dbFileName:
@@ -113,6 +117,8 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 		return mergeImports(i.copyfromImports())
 	case batchFileName:
 		return mergeImports(i.batchImports())
+	case registryFileName:
+		return mergeImports(i.registryImports())
 	default:
 		return mergeImports(i.queryImports(filename))
 	}
@@ -124,6 +130,8 @@ func (i *importer) dbImports() fileImports {
 		{Path: "context"},
 	}
 
+	useFmt := usesMaxRows(i.Queries)
+
 	sqlpkg := parseDriver(i.Options.SqlPackage)
 	switch sqlpkg {
 	case opts.SQLDriverPGXV4:
@@ -135,10 +143,14 @@ func (i *importer) dbImports() fileImports {
 	default:
 		std = append(std, ImportSpec{Path: "database/sql"})
 		if i.Options.EmitPreparedQueries {
-			std = append(std, ImportSpec{Path: "fmt"})
+			useFmt = true
 		}
 	}
 
+	if useFmt {
+		std = append(std, ImportSpec{Path: "fmt"})
+	}
+
 	sort.Slice(std, func(i, j int) bool { return std[i].Path < std[j].Path })
 	sort.Slice(pkg, func(i, j int) bool { return pkg[i].Path < pkg[j].Path })
 	return fileImports{Std: std, Dep: pkg}
@@ -279,6 +291,14 @@ func (i *importer) modelImports() fileImports {
 		std["database/sql/driver"] = struct{}{}
 	}
 
+	for _, s := range i.Structs {
+		if s.HasSensitiveFields() {
+			std["fmt"] = struct{}{}
+			std["log/slog"] = struct{}{}
+			break
+		}
+	}
+
 	return sortedImports(std, pkg)
 }
 
@@ -406,9 +426,30 @@ func (i *importer) queryImports(filename string) fileImports {
 		std["fmt"] = struct{}{}
 	}
 
+	if usesRedaction(gq) {
+		std["fmt"] = struct{}{}
+		std["log/slog"] = struct{}{}
+	}
+
 	return sortedImports(std, pkg)
 }
 
+// usesRedaction reports whether any struct emitted for the queries gets the
+// masking String and LogValue methods.
+func usesRedaction(queries []Query) bool {
+	for _, q := range queries {
+		// Batch templates emit the argument struct whenever there is one
+		emitArg := q.Arg.EmitStruct() || (usesBatch([]Query{q}) && q.Arg.IsStruct())
+		if emitArg && q.Arg.Struct.HasSensitiveFields() {
+			return true
+		}
+		if q.hasRetType() && q.Ret.EmitStruct() && q.Ret.Struct.HasSensitiveFields() {
+			return true
+		}
+	}
+	return false
+}
+
 func (i *importer) copyfromImports() fileImports {
 	copyFromQueries := make([]Query, 0, len(i.Queries))
 	for _, q := range i.Queries {
@@ -483,6 +524,10 @@ func (i *importer) batchImports() fileImports {
 
 	std["context"] = struct{}{}
 	std["errors"] = struct{}{}
+	if usesRedaction(batchQueries) {
+		std["fmt"] = struct{}{}
+		std["log/slog"] = struct{}{}
+	}
 	sqlpkg := parseDriver(i.Options.SqlPackage)
 	switch sqlpkg {
 	case opts.SQLDriverPGXV4:
@@ -494,6 +539,28 @@ func (i *importer) batchImports() fileImports {
 	return sortedImports(std, pkg)
 }
 
+func (i *importer) registryImports() fileImports {
+	std, pkg := buildImports(i.Options, nil, func(name string) bool {
+		for _, q := range i.Queries {
+			if q.hasRetType() {
+				if hasPrefixIgnoringSliceAndPointerPrefix(q.Ret.Type(), name) {
+					return true
+				}
+			}
+			for _, f := range q.Arg.Pairs() {
+				if hasPrefixIgnoringSliceAndPointerPrefix(f.Type, name) {
+					return true
+				}
+			}
+		}
+		return false
+	})
+
+	std["reflect"] = struct{}{}
+
+	return sortedImports(std, pkg)
+}
+
 func trimSliceAndPointerPrefix(v string) string {
 	v = strings.TrimPrefix(v, "[]")
 	v = strings.TrimPrefix(v, "*")
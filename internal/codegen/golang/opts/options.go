@@ -25,6 +25,8 @@ type Options struct {
 	EmitEnumValidMethod         bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues           bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
 	EmitSqlAsComment            bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
+	EmitQueryFactsAsComment     bool              `json:"emit_query_facts_as_comment,omitempty" yaml:"emit_query_facts_as_comment"`
+	EmitQueryRegistry           bool              `json:"emit_query_registry,omitempty" yaml:"emit_query_registry"`
 	JsonTagsCaseStyle           string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	Package                     string            `json:"package" yaml:"package"`
 	Out                         string            `json:"out" yaml:"out"`
@@ -37,6 +39,7 @@ type Options struct {
 	OutputModelsFileName        string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
 	OutputQuerierFileName       string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
 	OutputCopyfromFileName      string            `json:"output_copyfrom_file_name,omitempty" yaml:"output_copyfrom_file_name"`
+	OutputRegistryFileName      string            `json:"output_registry_file_name,omitempty" yaml:"output_registry_file_name"`
 	OutputFilesSuffix           string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
 	InflectionExcludeTableNames []string          `json:"inflection_exclude_table_names,omitempty" yaml:"inflection_exclude_table_names"`
 	WrapErrors                  bool              `json:"wrap_errors,omitempty" yaml:"wrap_errors"`
@@ -45,8 +48,10 @@ type Options struct {
 	OmitUnusedStructs           bool              `json:"omit_unused_structs,omitempty" yaml:"omit_unused_structs"`
 	BuildTags                   string            `json:"build_tags,omitempty" yaml:"build_tags"`
 	Initialisms                 *[]string         `json:"initialisms,omitempty" yaml:"initialisms"`
+	SensitiveColumns            []string          `json:"sensitive_columns,omitempty" yaml:"sensitive_columns"`
 
-	InitialismsMap map[string]struct{} `json:"-" yaml:"-"`
+	InitialismsMap   map[string]struct{} `json:"-" yaml:"-"`
+	SensitiveMatches []Override          `json:"-" yaml:"-"`
 }
 
 type GlobalOptions struct {
@@ -125,6 +130,18 @@ func parseOpts(req *plugin.GenerateRequest) (*Options, error) {
 		options.InitialismsMap[initial] = struct{}{}
 	}
 
+	schema := "public"
+	if req.Catalog != nil {
+		schema = req.Catalog.DefaultSchema
+	}
+	for _, spec := range options.SensitiveColumns {
+		o := Override{Column: spec}
+		if err := o.parseColumn(schema); err != nil {
+			return nil, fmt.Errorf("invalid options: sensitive_columns: %w", err)
+		}
+		options.SensitiveMatches = append(options.SensitiveMatches, o)
+	}
+
 	return &options, nil
 }
 
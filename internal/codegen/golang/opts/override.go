@@ -114,43 +114,8 @@ func (o *Override) parse(req *plugin.GenerateRequest) (err error) {
 
 	// validate Column
 	if o.Column != "" {
-		colParts := strings.Split(o.Column, ".")
-		switch len(colParts) {
-		case 2:
-			if o.ColumnName, err = pattern.MatchCompile(colParts[1]); err != nil {
-				return err
-			}
-			if o.TableRel, err = pattern.MatchCompile(colParts[0]); err != nil {
-				return err
-			}
-			if o.TableSchema, err = pattern.MatchCompile(schema); err != nil {
-				return err
-			}
-		case 3:
-			if o.ColumnName, err = pattern.MatchCompile(colParts[2]); err != nil {
-				return err
-			}
-			if o.TableRel, err = pattern.MatchCompile(colParts[1]); err != nil {
-				return err
-			}
-			if o.TableSchema, err = pattern.MatchCompile(colParts[0]); err != nil {
-				return err
-			}
-		case 4:
-			if o.ColumnName, err = pattern.MatchCompile(colParts[3]); err != nil {
-				return err
-			}
-			if o.TableRel, err = pattern.MatchCompile(colParts[2]); err != nil {
-				return err
-			}
-			if o.TableSchema, err = pattern.MatchCompile(colParts[1]); err != nil {
-				return err
-			}
-			if o.TableCatalog, err = pattern.MatchCompile(colParts[0]); err != nil {
-				return err
-			}
-		default:
-			return fmt.Errorf("Override `column` specifier %q is not the proper format, expected '[catalog.][schema.]tablename.colname'", o.Column)
+		if err := o.parseColumn(schema); err != nil {
+			return err
 		}
 	}
 
@@ -174,3 +139,47 @@ func (o *Override) parse(req *plugin.GenerateRequest) (err error) {
 	o.ShimOverride = shimOverride(req, o)
 	return nil
 }
+
+// parseColumn compiles a `[catalog.][schema.]tablename.colname` specifier
+// into the table and column matchers used by Matches.
+func (o *Override) parseColumn(schema string) (err error) {
+	colParts := strings.Split(o.Column, ".")
+	switch len(colParts) {
+	case 2:
+		if o.ColumnName, err = pattern.MatchCompile(colParts[1]); err != nil {
+			return err
+		}
+		if o.TableRel, err = pattern.MatchCompile(colParts[0]); err != nil {
+			return err
+		}
+		if o.TableSchema, err = pattern.MatchCompile(schema); err != nil {
+			return err
+		}
+	case 3:
+		if o.ColumnName, err = pattern.MatchCompile(colParts[2]); err != nil {
+			return err
+		}
+		if o.TableRel, err = pattern.MatchCompile(colParts[1]); err != nil {
+			return err
+		}
+		if o.TableSchema, err = pattern.MatchCompile(colParts[0]); err != nil {
+			return err
+		}
+	case 4:
+		if o.ColumnName, err = pattern.MatchCompile(colParts[3]); err != nil {
+			return err
+		}
+		if o.TableRel, err = pattern.MatchCompile(colParts[2]); err != nil {
+			return err
+		}
+		if o.TableSchema, err = pattern.MatchCompile(colParts[1]); err != nil {
+			return err
+		}
+		if o.TableCatalog, err = pattern.MatchCompile(colParts[0]); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("Override `column` specifier %q is not the proper format, expected '[catalog.][schema.]tablename.colname'", o.Column)
+	}
+	return nil
+}
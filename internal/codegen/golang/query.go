@@ -109,18 +109,7 @@ func (v *QueryValue) ReturnName() string {
 }
 
 func (v QueryValue) UniqueFields() []Field {
-	seen := map[string]struct{}{}
-	fields := make([]Field, 0, len(v.Struct.Fields))
-
-	for _, field := range v.Struct.Fields {
-		if _, found := seen[field.Name]; found {
-			continue
-		}
-		seen[field.Name] = struct{}{}
-		fields = append(fields, field)
-	}
-
-	return fields
+	return v.Struct.UniqueFields()
 }
 
 func (v QueryValue) Params() string {
@@ -267,6 +256,8 @@ type Query struct {
 	Arg          QueryValue
 	// Used for :copyfrom
 	Table *plugin.Identifier
+	// Set by a @max_rows comment on :many queries
+	MaxRows int
 }
 
 func (q Query) hasRetType() bool {
@@ -275,6 +266,12 @@ func (q Query) hasRetType() bool {
 	return scanned && !q.Ret.isEmpty()
 }
 
+// HasRetType exposes hasRetType to templates, which cannot call unexported
+// methods.
+func (q Query) HasRetType() bool {
+	return q.hasRetType()
+}
+
 func (q Query) TableIdentifierAsGoSlice() string {
 	escapedNames := make([]string, 0, 3)
 	for _, p := range []string{q.Table.Catalog, q.Table.Schema, q.Table.Name} {
@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
 	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/constants"
 	"github.com/sqlc-dev/sqlc/internal/inflection"
 	"github.com/sqlc-dev/sqlc/internal/metadata"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
@@ -93,12 +95,17 @@ func buildStructs(req *plugin.GenerateRequest, options *opts.Options) []Struct {
 				if options.EmitJsonTags {
 					tags["json"] = JSONTagName(column.Name, options)
 				}
+				sensitive := isSensitive(req, options, column)
+				if sensitive {
+					tags["sensitive"] = "true"
+				}
 				addExtraGoStructTags(tags, req, options, column)
 				s.Fields = append(s.Fields, Field{
-					Name:    StructName(column.Name, options),
-					Type:    goType(req, options, column),
-					Tags:    tags,
-					Comment: column.Comment,
+					Name:      StructName(column.Name, options),
+					Type:      goType(req, options, column),
+					Tags:      tags,
+					Comment:   column.Comment,
+					Sensitive: sensitive,
 				})
 			}
 			structs = append(structs, s)
@@ -183,6 +190,34 @@ func argName(name string) string {
 	return out
 }
 
+// queryFacts describes the statically known shape of a query, one comment
+// line per fact.
+func queryFacts(query *plugin.Query, maxRows int) []string {
+	var facts []string
+	if len(query.Tables) > 0 {
+		names := make([]string, 0, len(query.Tables))
+		for _, t := range query.Tables {
+			if t.Schema != "" {
+				names = append(names, t.Schema+"."+t.Name)
+			} else {
+				names = append(names, t.Name)
+			}
+		}
+		facts = append(facts, " Tables: "+strings.Join(names, ", "))
+	}
+	facts = append(facts, fmt.Sprintf(" Joins: %d", query.JoinCount))
+	if query.EstimatedRows > 0 {
+		facts = append(facts, fmt.Sprintf(" Estimated rows: %d", query.EstimatedRows))
+	}
+	switch {
+	case maxRows > 0:
+		facts = append(facts, fmt.Sprintf(" Max rows: %d", maxRows))
+	case query.Cmd == metadata.CmdMany && !query.HasLimit:
+		facts = append(facts, " Unbounded: no LIMIT clause")
+	}
+	return facts
+}
+
 func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []Struct) ([]Query, error) {
 	qs := make([]Query, 0, len(req.Queries))
 	for _, query := range req.Queries {
@@ -200,7 +235,19 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 			constantName = sdk.LowerTitle(query.Name)
 		}
 
-		comments := query.Comments
+		maxRows, err := parseMaxRows(query)
+		if err != nil {
+			return nil, err
+		}
+
+		comments := stripMaxRows(query.Comments)
+		if options.EmitQueryFactsAsComment {
+			if len(comments) == 0 {
+				comments = append(comments, query.Name)
+			}
+			comments = append(comments, " ")
+			comments = append(comments, queryFacts(query, maxRows)...)
+		}
 		if options.EmitSqlAsComment {
 			if len(comments) == 0 {
 				comments = append(comments, query.Name)
@@ -225,6 +272,7 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 			SQL:          query.Text,
 			Comments:     comments,
 			Table:        query.InsertIntoTable,
+			MaxRows:      maxRows,
 		}
 		sqlpkg := parseDriver(options.SqlPackage)
 
@@ -331,6 +379,43 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, structs []
 	return qs, nil
 }
 
+// parseMaxRows returns the row limit set by a "@max_rows <n>" comment, or zero
+// if the query has none.
+func parseMaxRows(query *plugin.Query) (int, error) {
+	for _, line := range query.Comments {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != constants.QueryFlagMaxRows {
+			continue
+		}
+		if query.Cmd != metadata.CmdMany {
+			return 0, fmt.Errorf("query %s: %s is only supported by %s queries", query.Name, constants.QueryFlagMaxRows, metadata.CmdMany)
+		}
+		if len(fields) != 2 {
+			return 0, fmt.Errorf("query %s: %s expects a single row count", query.Name, constants.QueryFlagMaxRows)
+		}
+		n, err := strconv.Atoi(fields[1])
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("query %s: invalid %s value %q", query.Name, constants.QueryFlagMaxRows, fields[1])
+		}
+		return n, nil
+	}
+	return 0, nil
+}
+
+// stripMaxRows removes the @max_rows directive from a query's comments so it
+// does not show up in the generated doc comment.
+func stripMaxRows(comments []string) []string {
+	var out []string
+	for _, line := range comments {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[0] == constants.QueryFlagMaxRows {
+			continue
+		}
+		out = append(out, line)
+	}
+	return out
+}
+
 var cmdReturnsData = map[string]struct{}{
 	metadata.CmdBatchMany: {},
 	metadata.CmdBatchOne:  {},
@@ -389,12 +474,17 @@ func columnsToStruct(req *plugin.GenerateRequest, options *opts.Options, name st
 		if options.EmitJsonTags {
 			tags["json"] = JSONTagName(tagName, options)
 		}
+		sensitive := c.embed == nil && isSensitive(req, options, c.Column)
+		if sensitive {
+			tags["sensitive"] = "true"
+		}
 		addExtraGoStructTags(tags, req, options, c.Column)
 		f := Field{
-			Name:   fieldName,
-			DBName: colName,
-			Tags:   tags,
-			Column: c.Column,
+			Name:      fieldName,
+			DBName:    colName,
+			Tags:      tags,
+			Column:    c.Column,
+			Sensitive: sensitive,
 		}
 		if c.embed == nil {
 			f.Type = goType(req, options, c.Column)
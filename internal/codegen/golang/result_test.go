@@ -76,3 +76,65 @@ func TestPutOutColumns_AlwaysTrueWhenQueryHasColumns(t *testing.T) {
 		t.Error("should be true when we have columns")
 	}
 }
+
+func TestParseMaxRows(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmd      string
+		comments []string
+		want     int
+		wantErr  bool
+	}{
+		{
+			name: "none",
+			cmd:  metadata.CmdMany,
+			want: 0,
+		},
+		{
+			name:     "valid",
+			cmd:      metadata.CmdMany,
+			comments: []string{" Lists authors", " @max_rows 100"},
+			want:     100,
+		},
+		{
+			name:     "not many",
+			cmd:      metadata.CmdOne,
+			comments: []string{" @max_rows 100"},
+			wantErr:  true,
+		},
+		{
+			name:     "missing value",
+			cmd:      metadata.CmdMany,
+			comments: []string{" @max_rows"},
+			wantErr:  true,
+		},
+		{
+			name:     "not positive",
+			cmd:      metadata.CmdMany,
+			comments: []string{" @max_rows 0"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			query := &plugin.Query{
+				Name:     "ListAuthors",
+				Cmd:      tc.cmd,
+				Comments: tc.comments,
+			}
+			got, err := parseMaxRows(query)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("parseMaxRows: expected error, got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMaxRows: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("parseMaxRows failed. want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -16,6 +16,30 @@ type Struct struct {
 	Comment string
 }
 
+func (s Struct) HasSensitiveFields() bool {
+	for _, f := range s.Fields {
+		if f.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// UniqueFields returns the struct fields without the duplicates produced by
+// repeated named parameters.
+func (s Struct) UniqueFields() []Field {
+	seen := map[string]struct{}{}
+	fields := make([]Field, 0, len(s.Fields))
+	for _, field := range s.Fields {
+		if _, found := seen[field.Name]; found {
+			continue
+		}
+		seen[field.Name] = struct{}{}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
 func StructName(name string, options *opts.Options) string {
 	if rename := options.Rename[name]; rename != "" {
 		return rename
@@ -18,6 +18,8 @@ type analysis struct {
 	Parameters []Parameter
 	Named      *named.ParamSet
 	Query      string
+
+	EstimatedRows int64
 }
 
 func convertTableName(id *analyzer.Identifier) *ast.TableName {
@@ -103,6 +105,7 @@ func combineAnalysis(prev *analysis, a *analyzer.Analysis) *analysis {
 	} else {
 		prev.Parameters = params
 	}
+	prev.EstimatedRows = a.EstimatedRows
 	return prev
 }
 
@@ -51,8 +51,11 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings) (*Compiler, err
 		c.selector = newDefaultSelector()
 		if conf.Database != nil {
 			if conf.Analyzer.Database == nil || *conf.Analyzer.Database {
+				// Row estimates cost a round trip per query, so only ask for
+				// them when the Go plugin will render them
+				estimateRows := conf.Gen.Go != nil && conf.Gen.Go.EmitQueryFactsAsComment
 				c.analyzer = analyzer.Cached(
-					pganalyze.New(c.client, *conf.Database),
+					pganalyze.New(c.client, *conf.Database, estimateRows),
 					combo.Global,
 					*conf.Database,
 				)
@@ -122,6 +122,11 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 
 	md.Comments = comments
 
+	tables, joins, err := referencedTables(raw.Stmt)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Query{
 		RawStmt:         raw,
 		Metadata:        md,
@@ -129,6 +134,10 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		Columns:         anlys.Columns,
 		SQL:             trimmed,
 		InsertIntoTable: anlys.Table,
+		Tables:          tables,
+		JoinCount:       joins,
+		HasLimit:        hasLimit(raw.Stmt),
+		EstimatedRows:   anlys.EstimatedRows,
 	}, nil
 }
 
@@ -144,6 +153,83 @@ func rangeVars(root ast.Node) []*ast.RangeVar {
 	return vars
 }
 
+// referencedTables returns the unique tables named in a statement, in the
+// order they first appear, along with the number of joins. Each explicit
+// JOIN counts once, as does each table beyond the first in a FROM list,
+// including the UPDATE or DELETE target. References to common table
+// expressions are not included.
+func referencedTables(root ast.Node) ([]*ast.TableName, int, error) {
+	ctes := map[string]struct{}{}
+	var joins int
+	find := astutils.VisitorFunc(func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.CommonTableExpr:
+			if n.Ctename != nil {
+				ctes[*n.Ctename] = struct{}{}
+			}
+		case *ast.JoinExpr:
+			joins++
+		case *ast.SelectStmt:
+			joins += implicitJoins(n.FromClause)
+		case *ast.UpdateStmt:
+			joins += implicitJoins(n.Relations, n.FromClause)
+		case *ast.DeleteStmt:
+			joins += implicitJoins(n.Relations, n.UsingClause)
+		}
+	})
+	astutils.Walk(find, root)
+
+	var tables []*ast.TableName
+	seen := map[ast.TableName]struct{}{}
+	for _, rv := range rangeVars(root) {
+		if rv.Relname == nil {
+			continue
+		}
+		fqn, err := ParseTableName(rv)
+		if err != nil {
+			return nil, 0, err
+		}
+		if _, ok := ctes[fqn.Name]; ok && fqn.Schema == "" {
+			continue
+		}
+		if _, ok := seen[*fqn]; ok {
+			continue
+		}
+		seen[*fqn] = struct{}{}
+		tables = append(tables, fqn)
+	}
+	return tables, joins, nil
+}
+
+// implicitJoins counts the tables beyond the first across FROM-like lists.
+func implicitJoins(lists ...*ast.List) int {
+	var n int
+	for _, l := range lists {
+		if l != nil {
+			n += len(l.Items)
+		}
+	}
+	return max(n-1, 0)
+}
+
+func hasLimit(stmt ast.Node) bool {
+	var limit ast.Node
+	switch n := stmt.(type) {
+	case *ast.SelectStmt:
+		limit = n.LimitCount
+	case *ast.UpdateStmt:
+		limit = n.LimitCount
+	case *ast.DeleteStmt:
+		limit = n.LimitCount
+	}
+	if limit == nil {
+		return false
+	}
+	// The PostgreSQL engine converts missing clauses to TODO nodes
+	_, todo := limit.(*ast.TODO)
+	return !todo
+}
+
 func uniqueParamRefs(in []paramRef, dollar bool) []paramRef {
 	m := make(map[int]bool, len(in))
 	o := make([]paramRef, 0, len(in))
@@ -51,6 +51,14 @@ type Query struct {
 	// Needed for CopyFrom
 	InsertIntoTable *ast.TableName
 
+	// Static facts about the statement
+	Tables    []*ast.TableName
+	JoinCount int
+	HasLimit  bool
+
+	// Planner estimate from a database analyzer, or zero if unknown
+	EstimatedRows int64
+
 	// Needed for vet
 	RawStmt *ast.RawStmt
 }
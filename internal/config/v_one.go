@@ -42,6 +42,8 @@ type v1PackageSettings struct {
 	EmitEnumValidMethod       bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues         bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
 	EmitSqlAsComment          bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
+	EmitQueryFactsAsComment   bool              `json:"emit_query_facts_as_comment,omitempty" yaml:"emit_query_facts_as_comment"`
+	EmitQueryRegistry         bool              `json:"emit_query_registry,omitempty" yaml:"emit_query_registry"`
 	JSONTagsCaseStyle         string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	SQLPackage                string            `json:"sql_package" yaml:"sql_package"`
 	SQLDriver                 string            `json:"sql_driver" yaml:"sql_driver"`
@@ -51,12 +53,14 @@ type v1PackageSettings struct {
 	OutputModelsFileName      string            `json:"output_models_file_name,omitempty" yaml:"output_models_file_name"`
 	OutputQuerierFileName     string            `json:"output_querier_file_name,omitempty" yaml:"output_querier_file_name"`
 	OutputCopyFromFileName    string            `json:"output_copyfrom_file_name,omitempty" yaml:"output_copyfrom_file_name"`
+	OutputRegistryFileName    string            `json:"output_registry_file_name,omitempty" yaml:"output_registry_file_name"`
 	OutputFilesSuffix         string            `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
 	StrictFunctionChecks      bool              `json:"strict_function_checks" yaml:"strict_function_checks"`
 	StrictOrderBy             *bool             `json:"strict_order_by" yaml:"strict_order_by"`
 	QueryParameterLimit       *int32            `json:"query_parameter_limit,omitempty" yaml:"query_parameter_limit"`
 	OmitSqlcVersion           bool              `json:"omit_sqlc_version,omitempty" yaml:"omit_sqlc_version"`
 	OmitUnusedStructs         bool              `json:"omit_unused_structs,omitempty" yaml:"omit_unused_structs"`
+	SensitiveColumns          []string          `json:"sensitive_columns,omitempty" yaml:"sensitive_columns"`
 	Rules                     []string          `json:"rules" yaml:"rules"`
 	BuildTags                 string            `json:"build_tags,omitempty" yaml:"build_tags"`
 }
@@ -152,6 +156,8 @@ func (c *V1GenerateSettings) Translate() Config {
 					EmitEnumValidMethod:       pkg.EmitEnumValidMethod,
 					EmitAllEnumValues:         pkg.EmitAllEnumValues,
 					EmitSqlAsComment:          pkg.EmitSqlAsComment,
+					EmitQueryFactsAsComment:   pkg.EmitQueryFactsAsComment,
+					EmitQueryRegistry:         pkg.EmitQueryRegistry,
 					Package:                   pkg.Name,
 					Out:                       pkg.Path,
 					SqlPackage:                pkg.SQLPackage,
@@ -163,10 +169,12 @@ func (c *V1GenerateSettings) Translate() Config {
 					OutputModelsFileName:      pkg.OutputModelsFileName,
 					OutputQuerierFileName:     pkg.OutputQuerierFileName,
 					OutputCopyfromFileName:    pkg.OutputCopyFromFileName,
+					OutputRegistryFileName:    pkg.OutputRegistryFileName,
 					OutputFilesSuffix:         pkg.OutputFilesSuffix,
 					QueryParameterLimit:       pkg.QueryParameterLimit,
 					OmitSqlcVersion:           pkg.OmitSqlcVersion,
 					OmitUnusedStructs:         pkg.OmitUnusedStructs,
+					SensitiveColumns:          pkg.SensitiveColumns,
 					BuildTags:                 pkg.BuildTags,
 				},
 			},
@@ -4,6 +4,7 @@ package constants
 const (
 	QueryFlagParam          = "@param"
 	QueryFlagSqlcVetDisable = "@sqlc-vet-disable"
+	QueryFlagMaxRows        = "@max_rows"
 )
 
 // Rules
@@ -0,0 +1,3 @@
+-- name: ListMaxRowsErrors :many
+-- @max_rows 5
+SELECT id FROM max_rows_errors;
@@ -0,0 +1 @@
+CREATE TABLE max_rows_errors (id serial not null);
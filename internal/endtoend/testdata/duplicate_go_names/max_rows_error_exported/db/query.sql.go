@@ -0,0 +1,41 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package db
+
+import (
+	"context"
+)
+
+const MaxRowsError = `-- name: MaxRowsError :many
+SELECT id FROM bar
+`
+
+// @max_rows 5
+func (q *Queries) MaxRowsError(ctx context.Context) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, MaxRowsError)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		if len(items) == 5 {
+			return nil, &MaxRowsError{Query: "MaxRowsError", MaxRows: 5}
+		}
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
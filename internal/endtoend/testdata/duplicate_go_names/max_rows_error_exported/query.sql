@@ -0,0 +1,3 @@
+-- name: MaxRowsError :many
+-- @max_rows 5
+SELECT id FROM bar;
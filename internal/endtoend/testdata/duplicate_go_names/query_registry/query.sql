@@ -0,0 +1,2 @@
+-- name: QueryRegistry :many
+SELECT id FROM bar;
@@ -0,0 +1,2 @@
+-- name: QueryInfo :many
+SELECT id FROM bar;
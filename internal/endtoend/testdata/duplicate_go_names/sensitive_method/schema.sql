@@ -0,0 +1,5 @@
+CREATE TABLE users (
+  id            serial NOT NULL,
+  password_hash text   NOT NULL,
+  string        text   NOT NULL
+);
@@ -0,0 +1,2 @@
+-- name: GetUser :one
+SELECT password_hash, name AS log_value FROM users WHERE id = $1;
@@ -0,0 +1,5 @@
+CREATE TABLE users (
+  id            serial NOT NULL,
+  password_hash text   NOT NULL,
+  name          text   NOT NULL
+);
@@ -0,0 +1,15 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1;
+
+-- name: ListFirstAuthors :many
+SELECT * FROM authors
+ORDER BY id
+LIMIT 5;
+
+-- name: CountAuthors :one
+SELECT count(*) FROM authors;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
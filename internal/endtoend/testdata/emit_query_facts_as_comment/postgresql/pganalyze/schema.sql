@@ -0,0 +1,10 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL
+);
+
+CREATE TABLE books (
+  id        BIGSERIAL PRIMARY KEY,
+  author_id BIGINT    NOT NULL REFERENCES authors (id),
+  title     text      NOT NULL
+);
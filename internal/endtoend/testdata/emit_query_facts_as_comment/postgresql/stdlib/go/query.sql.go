@@ -0,0 +1,248 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const deleteAuthor = `-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1
+`
+
+// DeleteAuthor
+//
+// Tables: authors
+// Joins: 0
+func (q *Queries) DeleteAuthor(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthor, id)
+	return err
+}
+
+const getAuthor = `-- name: GetAuthor :one
+SELECT id, name FROM authors
+WHERE id = $1 LIMIT 1
+`
+
+// GetAuthor
+//
+// Tables: authors
+// Joins: 0
+func (q *Queries) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthor, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const listAuthorTitlePairs = `-- name: ListAuthorTitlePairs :many
+SELECT authors.name, books.title
+FROM authors, books
+WHERE books.author_id = authors.id
+LIMIT $1
+`
+
+type ListAuthorTitlePairsRow struct {
+	Name  string
+	Title string
+}
+
+// ListAuthorTitlePairs
+//
+// Tables: authors, books
+// Joins: 1
+func (q *Queries) ListAuthorTitlePairs(ctx context.Context, limit int32) ([]ListAuthorTitlePairsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorTitlePairs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorTitlePairsRow
+	for rows.Next() {
+		var i ListAuthorTitlePairsRow
+		if err := rows.Scan(&i.Name, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthors = `-- name: ListAuthors :many
+SELECT id, name FROM authors
+ORDER BY name
+`
+
+// ListAuthors
+//
+// Tables: authors
+// Joins: 0
+// Unbounded: no LIMIT clause
+func (q *Queries) ListAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsCapped = `-- name: ListAuthorsCapped :many
+SELECT id, name FROM authors
+ORDER BY name
+`
+
+// ListAuthorsCapped
+//
+// Tables: authors
+// Joins: 0
+// Max rows: 100
+func (q *Queries) ListAuthorsCapped(ctx context.Context) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorsCapped)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		if len(items) == 100 {
+			return nil, &MaxRowsError{Query: "ListAuthorsCapped", MaxRows: 100}
+		}
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProlificAuthors = `-- name: ListProlificAuthors :many
+WITH counts AS (
+  SELECT author_id, count(*) AS total
+  FROM books
+  GROUP BY author_id
+)
+SELECT authors.name, counts.total
+FROM authors
+JOIN counts ON counts.author_id = authors.id
+JOIN books ON books.author_id = authors.id
+`
+
+type ListProlificAuthorsRow struct {
+	Name  string
+	Total int64
+}
+
+// ListProlificAuthors
+//
+// Tables: authors, books
+// Joins: 2
+// Unbounded: no LIMIT clause
+func (q *Queries) ListProlificAuthors(ctx context.Context) ([]ListProlificAuthorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProlificAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListProlificAuthorsRow
+	for rows.Next() {
+		var i ListProlificAuthorsRow
+		if err := rows.Scan(&i.Name, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentTitles = `-- name: ListRecentTitles :many
+SELECT books.title, authors.name
+FROM books
+JOIN authors ON authors.id = books.author_id
+ORDER BY books.id DESC
+LIMIT $1
+`
+
+type ListRecentTitlesRow struct {
+	Title string
+	Name  string
+}
+
+// Lists the most recent titles with their author
+//
+// Tables: books, authors
+// Joins: 1
+func (q *Queries) ListRecentTitles(ctx context.Context, limit int32) ([]ListRecentTitlesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentTitles, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecentTitlesRow
+	for rows.Next() {
+		var i ListRecentTitlesRow
+		if err := rows.Scan(&i.Title, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renameBooksByAuthor = `-- name: RenameBooksByAuthor :exec
+UPDATE books SET title = authors.name
+FROM authors
+WHERE authors.id = books.author_id AND authors.id = $1
+`
+
+// RenameBooksByAuthor
+//
+// Tables: books, authors
+// Joins: 1
+func (q *Queries) RenameBooksByAuthor(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, renameBooksByAuthor, id)
+	return err
+}
@@ -0,0 +1,36 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = ? LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: ListRecentTitles :many
+-- Lists the most recent titles with their author
+SELECT books.title, authors.name
+FROM books
+JOIN authors ON authors.id = books.author_id
+ORDER BY books.id DESC
+LIMIT ?;
+
+-- name: ListProlificAuthors :many
+WITH counts AS (
+  SELECT author_id, count(*) AS total
+  FROM books
+  GROUP BY author_id
+)
+SELECT authors.name, counts.total
+FROM authors
+JOIN counts ON counts.author_id = authors.id
+JOIN books ON books.author_id = authors.id;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = ?;
+
+-- name: ListAuthorTitlePairs :many
+SELECT authors.name, books.title
+FROM authors, books
+WHERE books.author_id = authors.id
+LIMIT ?;
@@ -0,0 +1,61 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: batch.go
+
+package querytest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrBatchAlreadyClosed = errors.New("batch already closed")
+)
+
+const deleteAuthorsByName = `-- name: DeleteAuthorsByName :batchexec
+DELETE FROM authors
+WHERE name = $1
+`
+
+type DeleteAuthorsByNameBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) DeleteAuthorsByName(ctx context.Context, name []string) *DeleteAuthorsByNameBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range name {
+		vals := []interface{}{
+			a,
+		}
+		batch.Queue(deleteAuthorsByName, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &DeleteAuthorsByNameBatchResults{br, len(name), false}
+}
+
+func (b *DeleteAuthorsByNameBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *DeleteAuthorsByNameBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
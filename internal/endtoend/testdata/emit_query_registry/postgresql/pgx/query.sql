@@ -0,0 +1,25 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: ListAuthorsCreatedSince :many
+SELECT id, name FROM authors
+WHERE created_at > $1;
+
+-- name: UpdateAuthorBio :exec
+UPDATE authors SET bio = $2
+WHERE id = $1;
+
+-- name: CountAuthors :one
+SELECT count(*) FROM authors;
+
+-- name: CreateAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: DeleteAuthorsByName :batchexec
+DELETE FROM authors
+WHERE name = $1;
@@ -0,0 +1,98 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package querytest
+
+import (
+	"reflect"
+	"time"
+)
+
+// QueryName identifies a query generated by sqlc.
+type QueryName string
+
+const (
+	QueryNameCountAuthors            QueryName = "CountAuthors"
+	QueryNameGetAuthor               QueryName = "GetAuthor"
+	QueryNameListAuthors             QueryName = "ListAuthors"
+	QueryNameListAuthorsCreatedSince QueryName = "ListAuthorsCreatedSince"
+	QueryNameUpdateAuthorBio         QueryName = "UpdateAuthorBio"
+)
+
+// QueryParam describes a single argument of a generated query method.
+type QueryParam struct {
+	Name string
+	Type reflect.Type
+}
+
+// QueryInfo describes a query generated by sqlc.
+type QueryInfo struct {
+	Name QueryName
+	// SQL is the query text sent to the database.
+	SQL string
+	// Cmd is the query annotation, e.g. ":one" or ":exec".
+	Cmd string
+	// Params lists the method arguments in order, excluding the context.
+	Params []QueryParam
+	// Result is the type of a single result row, or nil if the query
+	// does not return rows.
+	Result reflect.Type
+}
+
+var queryRegistry = []QueryInfo{
+	{
+		Name:   QueryNameCountAuthors,
+		SQL:    countAuthors,
+		Cmd:    ":one",
+		Result: reflect.TypeOf((*int64)(nil)).Elem(),
+	},
+	{
+		Name: QueryNameGetAuthor,
+		SQL:  getAuthor,
+		Cmd:  ":one",
+		Params: []QueryParam{
+			{Name: "id", Type: reflect.TypeOf((*int64)(nil)).Elem()},
+		},
+		Result: reflect.TypeOf((*Author)(nil)).Elem(),
+	},
+	{
+		Name:   QueryNameListAuthors,
+		SQL:    listAuthors,
+		Cmd:    ":many",
+		Result: reflect.TypeOf((*Author)(nil)).Elem(),
+	},
+	{
+		Name: QueryNameListAuthorsCreatedSince,
+		SQL:  listAuthorsCreatedSince,
+		Cmd:  ":many",
+		Params: []QueryParam{
+			{Name: "createdAt", Type: reflect.TypeOf((*time.Time)(nil)).Elem()},
+		},
+		Result: reflect.TypeOf((*ListAuthorsCreatedSinceRow)(nil)).Elem(),
+	},
+	{
+		Name: QueryNameUpdateAuthorBio,
+		SQL:  updateAuthorBio,
+		Cmd:  ":exec",
+		Params: []QueryParam{
+			{Name: "arg", Type: reflect.TypeOf((*UpdateAuthorBioParams)(nil)).Elem()},
+		},
+	},
+}
+
+// AllQueries returns a description of every query generated by sqlc in
+// this package.
+func AllQueries() []QueryInfo {
+	return append([]QueryInfo(nil), queryRegistry...)
+}
+
+// LookupQuery returns the description of the named query.
+func LookupQuery(name QueryName) (QueryInfo, bool) {
+	for _, q := range queryRegistry {
+		if q.Name == name {
+			return q, true
+		}
+	}
+	return QueryInfo{}, false
+}
@@ -0,0 +1,18 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: ListAuthorsCreatedSince :many
+SELECT id, name FROM authors
+WHERE created_at > $1;
+
+-- name: UpdateAuthorBio :exec
+UPDATE authors SET bio = $2
+WHERE id = $1;
+
+-- name: CountAuthors :one
+SELECT count(*) FROM authors;
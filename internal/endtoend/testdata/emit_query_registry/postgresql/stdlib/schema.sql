@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+          id         BIGSERIAL PRIMARY KEY,
+          name       text      NOT NULL,
+          bio        text,
+          created_at timestamp NOT NULL DEFAULT now()
+);
@@ -0,0 +1,6 @@
+-- name: ListEvents :many
+-- @max_rows 10000
+SELECT * FROM events;
+
+-- name: ListEventKinds :many
+SELECT DISTINCT kind FROM events;
@@ -0,0 +1,4 @@
+CREATE TABLE events (
+          id   BIGSERIAL PRIMARY KEY,
+          kind text      NOT NULL
+);
@@ -0,0 +1,33 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+
+package querytest
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+type User struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	// Contact address @sensitive
+	Email        string `json:"email" sensitive:"true"`
+	PasswordHash string `json:"password_hash" sensitive:"true"`
+}
+
+// String implements fmt.Stringer, masking sensitive fields.
+func (v User) String() string {
+	return fmt.Sprintf("User{ID:%v Name:%v Email:[REDACTED] PasswordHash:[REDACTED]}", v.ID, v.Name)
+}
+
+// LogValue implements slog.LogValuer, masking sensitive fields.
+func (v User) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("ID", v.ID),
+		slog.Any("Name", v.Name),
+		slog.String("Email", "[REDACTED]"),
+		slog.String("PasswordHash", "[REDACTED]"),
+	)
+}
@@ -0,0 +1,103 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+const createUser = `-- name: CreateUser :exec
+INSERT INTO users (name, email, password_hash) VALUES ($1, $2, $3)
+`
+
+type CreateUserParams struct {
+	Name         string `json:"name"`
+	Email        string `json:"email" sensitive:"true"`
+	PasswordHash string `json:"password_hash" sensitive:"true"`
+}
+
+// String implements fmt.Stringer, masking sensitive fields.
+func (v CreateUserParams) String() string {
+	return fmt.Sprintf("CreateUserParams{Name:%v Email:[REDACTED] PasswordHash:[REDACTED]}", v.Name)
+}
+
+// LogValue implements slog.LogValuer, masking sensitive fields.
+func (v CreateUserParams) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("Name", v.Name),
+		slog.String("Email", "[REDACTED]"),
+		slog.String("PasswordHash", "[REDACTED]"),
+	)
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
+	_, err := q.db.ExecContext(ctx, createUser, arg.Name, arg.Email, arg.PasswordHash)
+	return err
+}
+
+const getUser = `-- name: GetUser :one
+SELECT id, name, email, password_hash FROM users
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetUser(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.PasswordHash,
+	)
+	return i, err
+}
+
+const listUserLogins = `-- name: ListUserLogins :many
+SELECT name, password_hash FROM users
+`
+
+type ListUserLoginsRow struct {
+	Name         string `json:"name"`
+	PasswordHash string `json:"password_hash" sensitive:"true"`
+}
+
+// String implements fmt.Stringer, masking sensitive fields.
+func (v ListUserLoginsRow) String() string {
+	return fmt.Sprintf("ListUserLoginsRow{Name:%v PasswordHash:[REDACTED]}", v.Name)
+}
+
+// LogValue implements slog.LogValuer, masking sensitive fields.
+func (v ListUserLoginsRow) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("Name", v.Name),
+		slog.String("PasswordHash", "[REDACTED]"),
+	)
+}
+
+func (q *Queries) ListUserLogins(ctx context.Context) ([]ListUserLoginsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserLogins)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserLoginsRow
+	for rows.Next() {
+		var i ListUserLoginsRow
+		if err := rows.Scan(&i.Name, &i.PasswordHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,9 @@
+-- name: GetUser :one
+SELECT * FROM users
+WHERE id = $1 LIMIT 1;
+
+-- name: ListUserLogins :many
+SELECT name, password_hash FROM users;
+
+-- name: CreateUser :exec
+INSERT INTO users (name, email, password_hash) VALUES ($1, $2, $3);
@@ -0,0 +1,8 @@
+CREATE TABLE users (
+          id            BIGSERIAL PRIMARY KEY,
+          name          text      NOT NULL,
+          email         text      NOT NULL,
+          password_hash text      NOT NULL
+);
+
+COMMENT ON COLUMN users.email IS 'Contact address @sensitive';
@@ -2,8 +2,10 @@ package analyzer
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 	"sync"
 
@@ -30,14 +32,18 @@ type Analyzer struct {
 	formats  sync.Map
 	columns  sync.Map
 	tables   sync.Map
+
+	// Ask the planner for row estimates when generating query facts
+	estimateRows bool
 }
 
-func New(client dbmanager.Client, db config.Database) *Analyzer {
+func New(client dbmanager.Client, db config.Database, estimateRows bool) *Analyzer {
 	return &Analyzer{
-		db:       db,
-		dbg:      opts.DebugFromEnv(),
-		client:   client,
-		replacer: shfmt.NewReplacer(nil),
+		db:           db,
+		dbg:          opts.DebugFromEnv(),
+		client:       client,
+		replacer:     shfmt.NewReplacer(nil),
+		estimateRows: estimateRows,
 	}
 }
 
@@ -311,9 +317,51 @@ func (a *Analyzer) Analyze(ctx context.Context, n ast.Node, query string, migrat
 		})
 	}
 
+	if a.estimateRows && len(desc.Fields) > 0 {
+		rows, err := estimateRows(ctx, c.Conn().PgConn(), query, len(desc.ParamOIDs) > 0)
+		if err != nil {
+			slog.Warn("estimating query rows failed", "query", query, "err", err)
+		}
+		result.EstimatedRows = rows
+	}
+
 	return &result, nil
 }
 
+// estimateRows asks the planner how many rows a query returns. Queries with
+// parameters need EXPLAIN (GENERIC_PLAN), which was added in PostgreSQL 16.
+func estimateRows(ctx context.Context, conn *pgconn.PgConn, query string, hasParams bool) (int64, error) {
+	options := "FORMAT JSON"
+	if hasParams {
+		options = "GENERIC_PLAN, " + options
+	}
+	results, err := conn.Exec(ctx, "EXPLAIN ("+options+") "+query).ReadAll()
+	if err != nil {
+		return 0, err
+	}
+	if len(results) == 0 || len(results[0].Rows) == 0 || len(results[0].Rows[0]) == 0 {
+		return 0, errors.New("explain returned no rows")
+	}
+	return parsePlanRows(results[0].Rows[0][0])
+}
+
+// parsePlanRows returns the row estimate of the top plan node in the output
+// of EXPLAIN (FORMAT JSON).
+func parsePlanRows(plan []byte) (int64, error) {
+	var explain []struct {
+		Plan struct {
+			PlanRows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal(plan, &explain); err != nil {
+		return 0, err
+	}
+	if len(explain) == 0 {
+		return 0, errors.New("explain returned no plans")
+	}
+	return int64(explain[0].Plan.PlanRows), nil
+}
+
 func (a *Analyzer) Close(_ context.Context) error {
 	if a.pool != nil {
 		a.pool.Close()
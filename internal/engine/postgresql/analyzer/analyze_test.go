@@ -0,0 +1,44 @@
+package analyzer
+
+import (
+	"testing"
+)
+
+func TestParsePlanRows(t *testing.T) {
+	for _, tc := range []struct {
+		plan    string
+		rows    int64
+		wantErr bool
+	}{
+		{
+			plan: `[{"Plan": {"Node Type": "Seq Scan", "Relation Name": "authors", "Plan Rows": 1270, "Plan Width": 40}}]`,
+			rows: 1270,
+		},
+		{
+			plan: `[{"Plan": {"Node Type": "Limit", "Plan Rows": 1, "Plans": [{"Node Type": "Index Scan", "Plan Rows": 1}]}}]`,
+			rows: 1,
+		},
+		{
+			plan:    `[]`,
+			wantErr: true,
+		},
+		{
+			plan:    `not json`,
+			wantErr: true,
+		},
+	} {
+		rows, err := parsePlanRows([]byte(tc.plan))
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("parsePlanRows(%q): expected error, got %d", tc.plan, rows)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parsePlanRows(%q): %s", tc.plan, err)
+		}
+		if rows != tc.rows {
+			t.Errorf("parsePlanRows(%q) = %d, want %d", tc.plan, rows, tc.rows)
+		}
+	}
+}